@@ -33,6 +33,12 @@ type ManagedClusterRole struct {
 	// Rules holds all the PolicyRules for this ClusterRole
 	// +optional
 	Rules []rbac.PolicyRule `json:"rules"`
+
+	// AggregationRule describes how to locate ClusterRoles to aggregate into this
+	// ClusterRole. If set, Rules is treated as empty and the spoke ClusterRole's
+	// rules are filled in by the Kubernetes aggregation controller instead.
+	// +optional
+	AggregationRule *rbac.AggregationRule `json:"aggregationRule,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,134 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+
+// ManagedClusterRoleBinding is the Schema for the managedclusterrolebindings API
+type ManagedClusterRoleBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Subjects holds references to the objects the role applies to
+	// +kubebuilder:validation:MinItems=1
+	Subjects []Subject `json:"subjects"`
+
+	// RoleRefs references the Roles/ClusterRoles being granted to the Subjects.
+	// Each entry gets a downstream ClusterRoleBinding, or one RoleBinding per
+	// namespace listed in its Namespaces.
+	RoleRefs []RoleRef `json:"roleRefs"`
+
+	// Status reflects the most recently observed state of this ManagedClusterRoleBinding.
+	// +optional
+	Status ManagedClusterRoleBindingStatus `json:"status,omitempty"`
+}
+
+// Condition types reported on a ManagedClusterRoleBinding's status.
+const (
+	// ConditionImpersonateRoleReady indicates whether the shared impersonate
+	// ClusterRole/ClusterRoleBinding (Role/RoleBinding for a ServiceAccount
+	// subject) for each Subject has been applied.
+	ConditionImpersonateRoleReady = "ImpersonateRoleReady"
+
+	// ConditionBindingApplied indicates whether every RoleRef has a
+	// corresponding RoleBinding/ClusterRoleBinding applied on the spoke.
+	ConditionBindingApplied = "BindingApplied"
+
+	// ConditionDegraded indicates the reconciler failed to bring the spoke to
+	// the desired state.
+	ConditionDegraded = "Degraded"
+)
+
+// ManagedClusterRoleBindingStatus defines the observed state of ManagedClusterRoleBinding
+type ManagedClusterRoleBindingStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the binding's state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// AppliedBindings records the downstream RoleBinding/ClusterRoleBinding
+	// created on the spoke: one entry for a cluster-scoped RoleRef, and one
+	// entry per listed namespace for a RoleRef with Namespaces.
+	// +optional
+	AppliedBindings []AppliedBinding `json:"appliedBindings,omitempty"`
+}
+
+// AppliedBinding references a downstream ClusterRoleBinding/RoleBinding
+// created on the spoke for a single RoleRef entry.
+type AppliedBinding struct {
+	// Name of the created ClusterRoleBinding/RoleBinding.
+	Name string `json:"name"`
+
+	// Namespace of the created RoleBinding. Empty for a cluster-scoped
+	// ClusterRoleBinding.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Subject contains a reference to the object or user identity a role binding applies to.
+type Subject struct {
+	// Kind of object being referenced. Values defined by this API group are
+	// "User", "Group", and "ServiceAccount".
+	// +kubebuilder:validation:Enum=User;Group;ServiceAccount
+	Kind string `json:"kind"`
+
+	// Name of the object being referenced.
+	Name string `json:"name"`
+
+	// Namespace of the referenced object. Required for ServiceAccount subjects,
+	// ignored otherwise.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// RoleRef references a single Role or ClusterRole being granted to the Subjects.
+type RoleRef struct {
+	// Kind of the referenced role. Either "Role" or "ClusterRole".
+	// +kubebuilder:validation:Enum=Role;ClusterRole
+	// +kubebuilder:default=ClusterRole
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the referenced Role/ClusterRole
+	Name string `json:"name"`
+
+	// Namespaces in which to create a RoleBinding for this ref. If empty, a
+	// ClusterRoleBinding is created against a ClusterRole of the same Name.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ManagedClusterRoleBindingList contains a list of ManagedClusterRoleBinding
+type ManagedClusterRoleBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedClusterRoleBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ManagedClusterRoleBinding{}, &ManagedClusterRoleBindingList{})
+}
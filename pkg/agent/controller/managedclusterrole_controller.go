@@ -0,0 +1,116 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	authzv1alpah1 "github.com/kluster-manager/cluster-auth/apis/authorization/v1alpha1"
+	"github.com/kluster-manager/cluster-auth/pkg/common"
+
+	rbac "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	cu "kmodules.xyz/client-go/client"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ManagedClusterRoleReconciler reconciles a ManagedClusterRole object
+type ManagedClusterRoleReconciler struct {
+	HubClient   client.Client
+	SpokeClient client.Client
+	Scheme      *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=authorization.k8s.appscode.com,resources=managedclusterroles,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=authorization.k8s.appscode.com,resources=managedclusterroles/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=authorization.k8s.appscode.com,resources=managedclusterroles/finalizers,verbs=update
+
+// Reconcile syncs a ManagedClusterRole on the hub to a native ClusterRole on the spoke.
+func (r *ManagedClusterRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Start reconciling...")
+
+	var managedCR authzv1alpah1.ManagedClusterRole
+	if err := r.HubClient.Get(ctx, req.NamespacedName, &managedCR); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Check if the managedCR is marked for deletion
+	if managedCR.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(&managedCR, common.SpokeAuthorizationFinalizer) {
+			cr := &rbac.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: managedCR.Name}}
+			if err := r.SpokeClient.Delete(context.TODO(), cr); err != nil && !apierrors.IsNotFound(err) {
+				return reconcile.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(&managedCR, common.SpokeAuthorizationFinalizer)
+			if err := r.SpokeClient.Update(context.TODO(), &managedCR); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+		return reconcile.Result{}, nil
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(&managedCR, common.SpokeAuthorizationFinalizer) {
+		controllerutil.AddFinalizer(&managedCR, common.SpokeAuthorizationFinalizer)
+		if err := r.SpokeClient.Update(context.TODO(), &managedCR); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// When AggregationRule is set, Rules is managed by Kubernetes' built-in
+	// aggregation controller and must be left empty on the spoke ClusterRole.
+	rules := managedCR.Rules
+	if managedCR.AggregationRule != nil {
+		rules = nil
+	}
+
+	cr := &rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   managedCR.Name,
+			Labels: managedCR.Labels,
+		},
+		Rules:           rules,
+		AggregationRule: managedCR.AggregationRule,
+	}
+
+	_, err := cu.CreateOrPatch(context.Background(), r.SpokeClient, cr, func(obj client.Object, createOp bool) client.Object {
+		in := obj.(*rbac.ClusterRole)
+		in.Labels = cr.Labels
+		in.Rules = cr.Rules
+		in.AggregationRule = cr.AggregationRule
+		return in
+	})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ManagedClusterRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&authzv1alpah1.ManagedClusterRole{}).
+		Complete(r)
+}
@@ -18,7 +18,10 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	authzv1alpah1 "github.com/kluster-manager/cluster-auth/apis/authorization/v1alpha1"
 	"github.com/kluster-manager/cluster-auth/pkg/common"
@@ -26,25 +29,54 @@ import (
 
 	core "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	cu "kmodules.xyz/client-go/client"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // ManagedClusterRoleBindingReconciler reconciles a ManagedClusterRoleBinding object
 type ManagedClusterRoleBindingReconciler struct {
 	HubClient   client.Client
 	SpokeClient client.Client
+	SpokeCache  cache.Cache
 	Scheme      *runtime.Scheme
 }
 
+// roleRefLabelKey records which RoleRef entry a downstream ClusterRoleBinding
+// or RoleBinding was created for, so removed entries can be garbage collected
+// without disturbing bindings still listed on the ManagedClusterRoleBinding.
+const roleRefLabelKey = "authorization.k8s.appscode.com/role-ref"
+
+// ownerUIDLabelKey identifies which ManagedClusterRoleBinding a downstream
+// object belongs to, using its UID. Unlike managedCRB.Labels (often shared
+// across sibling bindings, e.g. by user or hub owner), this value is unique
+// per binding so cleanup can target exactly the objects it created.
+const ownerUIDLabelKey = "authorization.k8s.appscode.com/owner-uid"
+
+// withOwnerUIDLabel returns a copy of base with ownerUIDLabelKey set to the
+// ManagedClusterRoleBinding's UID.
+func withOwnerUIDLabel(base map[string]string, managedCRB *authzv1alpah1.ManagedClusterRoleBinding) map[string]string {
+	out := make(map[string]string, len(base)+1)
+	for k, v := range base {
+		out[k] = v
+	}
+	out[ownerUIDLabelKey] = string(managedCRB.UID)
+	return out
+}
+
 //+kubebuilder:rbac:groups=authorization.k8s.appscode.com,resources=managedclusterrolebindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=authorization.k8s.appscode.com,resources=managedclusterrolebindings/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=authorization.k8s.appscode.com,resources=managedclusterrolebindings/finalizers,verbs=update
@@ -58,7 +90,7 @@ type ManagedClusterRoleBindingReconciler struct {
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.16.3/pkg/reconcile
-func (r *ManagedClusterRoleBindingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *ManagedClusterRoleBindingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
 	logger := log.FromContext(ctx)
 	logger.Info("Start reconciling...")
 
@@ -66,8 +98,22 @@ func (r *ManagedClusterRoleBindingReconciler) Reconcile(ctx context.Context, req
 	if err := r.HubClient.Get(ctx, req.NamespacedName, &managedCRB); err != nil {
 		return reconcile.Result{}, err
 	}
+
+	defer func() {
+		if reterr != nil && managedCRB.GetDeletionTimestamp() == nil {
+			_ = r.patchStatus(ctx, &managedCRB, func(status *authzv1alpah1.ManagedClusterRoleBindingStatus) {
+				status.ObservedGeneration = managedCRB.Generation
+				apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+					Type:    authzv1alpah1.ConditionDegraded,
+					Status:  metav1.ConditionTrue,
+					Reason:  "ReconcileError",
+					Message: reterr.Error(),
+				})
+			})
+		}
+	}()
+
 	_, hubOwnerID := utils.GetUserIDAndHubOwnerIDFromLabelValues(&managedCRB)
-	userName := managedCRB.Subjects[0].Name
 
 	// Check if the managedCRB is marked for deletion
 	if managedCRB.GetDeletionTimestamp() != nil {
@@ -90,119 +136,95 @@ func (r *ManagedClusterRoleBindingReconciler) Reconcile(ctx context.Context, req
 		return reconcile.Result{}, err
 	}
 
-	// impersonate clusterRole
-	cr := &rbac.ClusterRole{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   fmt.Sprintf("impersonate-%s-%s", userName, hubOwnerID),
-			Labels: managedCRB.Labels,
-		},
-		Rules: []rbac.PolicyRule{
-			{
-				APIGroups:     []string{""},
-				Resources:     []string{"users"},
-				Verbs:         []string{"impersonate"},
-				ResourceNames: []string{userName},
-			},
-		},
-	}
+	// ownerLabels marks every downstream object this reconcile touches with
+	// ownerUIDLabelKey so the drift watch in SetupWithManager can map it back
+	// to a ManagedClusterRoleBinding. The impersonate ClusterRole/
+	// ClusterRoleBinding below can be shared with sibling bindings for the
+	// same subject/hub owner, so this label only ever reflects whichever
+	// binding reconciled it most recently rather than a single true owner —
+	// that's fine here, since reconciling any sibling restores the same rule.
+	ownerLabels := withOwnerUIDLabel(managedCRB.Labels, &managedCRB)
 
-	_, err := cu.CreateOrPatch(context.Background(), r.SpokeClient, cr, func(obj client.Object, createOp bool) client.Object {
-		in := obj.(*rbac.ClusterRole)
-		in.Rules = cr.Rules
-		return in
-	})
-	if err != nil {
-		return reconcile.Result{}, err
+	// now give actual permission to every Subject
+	sub := make([]rbac.Subject, 0, len(managedCRB.Subjects))
+	for _, subject := range managedCRB.Subjects {
+		if err := r.applyImpersonationRBAC(subject, hubOwnerID, ownerLabels); err != nil {
+			return reconcile.Result{}, err
+		}
+		sub = append(sub, toRBACSubject(subject))
 	}
 
-	// this clusterRoleBinding will give permission to the user
-	crb := &rbac.ClusterRoleBinding{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:   fmt.Sprintf("impersonate-%s-%s-rolebinding", userName, hubOwnerID),
-			Labels: managedCRB.Labels,
-		},
-		Subjects: []rbac.Subject{
-			{
-				APIGroup:  "",
-				Kind:      "ServiceAccount",
-				Name:      "cluster-gateway",
-				Namespace: "open-cluster-management-managed-serviceaccount",
-			},
-		},
-		RoleRef: rbac.RoleRef{
-			APIGroup: rbac.GroupName,
-			Kind:     "ClusterRole",
-			Name:     cr.Name,
-		},
-	}
+	desiredRoleRefKeys := map[string]bool{}
+	var appliedBindings []authzv1alpah1.AppliedBinding
+	for _, ref := range managedCRB.RoleRefs {
+		kind := ref.Kind
+		if kind == "" {
+			kind = "ClusterRole"
+		}
 
-	_, err = cu.CreateOrPatch(context.Background(), r.SpokeClient, crb, func(obj client.Object, createOp bool) client.Object {
-		in := obj.(*rbac.ClusterRoleBinding)
-		in.Subjects = crb.Subjects
-		in.RoleRef = crb.RoleRef
-		return in
-	})
-	if err != nil {
-		return reconcile.Result{}, err
-	}
+		if len(ref.Namespaces) == 0 {
+			if kind == "Role" {
+				return reconcile.Result{}, fmt.Errorf("roleRef %q has Kind \"Role\" but no Namespaces: a ClusterRoleBinding can only reference a ClusterRole", ref.Name)
+			}
 
-	// now give actual permission to the User
-	sub := []rbac.Subject{
-		{
-			APIGroup: "",
-			Kind:     "User",
-			Name:     managedCRB.Subjects[0].Name,
-		},
-	}
+			key := roleRefKey(kind, ref.Name, "")
+			desiredRoleRefKeys[key] = true
 
-	if managedCRB.RoleRef.Namespaces == nil {
-		givenClusterRolebinding := &rbac.ClusterRoleBinding{
-			TypeMeta: metav1.TypeMeta{
-				APIVersion: rbac.SchemeGroupVersion.String(),
-				Kind:       "ClusterRoleBinding",
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name:   managedCRB.Name,
-				Labels: managedCRB.Labels,
-			},
-			Subjects: sub,
-			RoleRef: rbac.RoleRef{
-				APIGroup: rbac.GroupName,
-				Kind:     "ClusterRole",
-				Name:     managedCRB.RoleRef.Name,
-			},
-		}
-		_, err = cu.CreateOrPatch(context.Background(), r.SpokeClient, givenClusterRolebinding, func(obj client.Object, createOp bool) client.Object {
-			in := obj.(*rbac.ClusterRoleBinding)
-			in.Subjects = givenClusterRolebinding.Subjects
-			in.RoleRef = givenClusterRolebinding.RoleRef
-			return in
-		})
-		if err != nil {
-			return reconcile.Result{}, err
+			givenClusterRolebinding := &rbac.ClusterRoleBinding{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: rbac.SchemeGroupVersion.String(),
+					Kind:       "ClusterRoleBinding",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   roleRefBindingName(managedCRB.Name, kind, key),
+					Labels: withRoleRefLabel(ownerLabels, key),
+				},
+				Subjects: sub,
+				RoleRef: rbac.RoleRef{
+					APIGroup: rbac.GroupName,
+					Kind:     kind,
+					Name:     ref.Name,
+				},
+			}
+			_, err := cu.CreateOrPatch(context.Background(), r.SpokeClient, givenClusterRolebinding, func(obj client.Object, createOp bool) client.Object {
+				in := obj.(*rbac.ClusterRoleBinding)
+				in.Labels = givenClusterRolebinding.Labels
+				in.Subjects = givenClusterRolebinding.Subjects
+				in.RoleRef = givenClusterRolebinding.RoleRef
+				return in
+			})
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+			appliedBindings = append(appliedBindings, authzv1alpah1.AppliedBinding{Name: givenClusterRolebinding.Name})
+			continue
 		}
-	} else {
-		for _, ns := range managedCRB.RoleRef.Namespaces {
+
+		for _, ns := range ref.Namespaces {
+			key := roleRefKey(kind, ref.Name, ns)
+			desiredRoleRefKeys[key] = true
+
 			givenRolebinding := &rbac.RoleBinding{
 				TypeMeta: metav1.TypeMeta{
 					APIVersion: rbac.SchemeGroupVersion.String(),
 					Kind:       "RoleBinding",
 				},
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      managedCRB.Name,
+					Name:      roleRefBindingName(managedCRB.Name, kind, key),
 					Namespace: ns,
-					Labels:    managedCRB.Labels,
+					Labels:    withRoleRefLabel(ownerLabels, key),
 				},
 				Subjects: sub,
 				RoleRef: rbac.RoleRef{
 					APIGroup: rbac.GroupName,
-					Kind:     "Role",
-					Name:     managedCRB.RoleRef.Name,
+					Kind:     kind,
+					Name:     ref.Name,
 				},
 			}
 
-			_, err = cu.CreateOrPatch(context.Background(), r.SpokeClient, givenRolebinding, func(obj client.Object, createOp bool) client.Object {
+			_, err := cu.CreateOrPatch(context.Background(), r.SpokeClient, givenRolebinding, func(obj client.Object, createOp bool) client.Object {
 				in := obj.(*rbac.RoleBinding)
+				in.Labels = givenRolebinding.Labels
 				in.Subjects = givenRolebinding.Subjects
 				in.RoleRef = givenRolebinding.RoleRef
 				return in
@@ -210,11 +232,49 @@ func (r *ManagedClusterRoleBindingReconciler) Reconcile(ctx context.Context, req
 			if err != nil {
 				return reconcile.Result{}, err
 			}
+			appliedBindings = append(appliedBindings, authzv1alpah1.AppliedBinding{Name: givenRolebinding.Name, Namespace: ns})
 		}
 	}
+
+	if err := r.garbageCollectRemovedRoleRefs(&managedCRB, desiredRoleRefKeys); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.patchStatus(ctx, &managedCRB, func(status *authzv1alpah1.ManagedClusterRoleBindingStatus) {
+		status.ObservedGeneration = managedCRB.Generation
+		status.AppliedBindings = appliedBindings
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    authzv1alpah1.ConditionImpersonateRoleReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Reconciled",
+			Message: "impersonate ClusterRole and ClusterRoleBinding applied on the spoke",
+		})
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    authzv1alpah1.ConditionBindingApplied,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Reconciled",
+			Message: "all RoleRefs applied on the spoke",
+		})
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    authzv1alpah1.ConditionDegraded,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Reconciled",
+			Message: "",
+		})
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// patchStatus applies mutate to managedCRB's status and patches it on the hub.
+func (r *ManagedClusterRoleBindingReconciler) patchStatus(ctx context.Context, managedCRB *authzv1alpah1.ManagedClusterRoleBinding, mutate func(status *authzv1alpah1.ManagedClusterRoleBindingStatus)) error {
+	base := managedCRB.DeepCopy()
+	mutate(&managedCRB.Status)
+	return r.HubClient.Status().Patch(ctx, managedCRB, client.MergeFrom(base))
+}
+
 // AddFinalizerIfNeeded adds a finalizer to the CRD instance if it doesn't already have one
 func (r *ManagedClusterRoleBindingReconciler) addFinalizerIfNeeded(managedCRB *authzv1alpah1.ManagedClusterRoleBinding) error {
 	if !controllerutil.ContainsFinalizer(managedCRB, common.SpokeAuthorizationFinalizer) {
@@ -226,51 +286,412 @@ func (r *ManagedClusterRoleBindingReconciler) addFinalizerIfNeeded(managedCRB *a
 	return nil
 }
 
-func (r *ManagedClusterRoleBindingReconciler) deleteAssociatedResources(managedCRB *authzv1alpah1.ManagedClusterRoleBinding) error {
-	saList := core.ServiceAccountList{}
-	err := r.SpokeClient.List(context.TODO(), &saList, client.MatchingLabelsSelector{
-		Selector: labels.SelectorFromSet(managedCRB.Labels),
+// impersonateSubjectKey returns an identity for a subject that's unique per
+// Kind/Namespace/Name, so distinct subjects (e.g. the User "alice" and the
+// Group "alice") never collide on the same impersonate ClusterRole. Kind is
+// lower-cased; Namespace and Name are used as given and are not sanitized,
+// so the key is a valid name part only when they are.
+func impersonateSubjectKey(subject authzv1alpah1.Subject) string {
+	kind := strings.ToLower(subject.Kind)
+	if subject.Kind == "ServiceAccount" {
+		return fmt.Sprintf("%s-%s-%s", kind, subject.Namespace, subject.Name)
+	}
+	return fmt.Sprintf("%s-%s", kind, subject.Name)
+}
+
+// impersonateClusterRoleName returns the name of the shared impersonate
+// ClusterRole for a subject under a given hub owner.
+func impersonateClusterRoleName(subject authzv1alpah1.Subject, hubOwnerID string) string {
+	return fmt.Sprintf("impersonate-%s-%s", impersonateSubjectKey(subject), hubOwnerID)
+}
+
+// impersonateClusterRoleBindingName returns the name of the ClusterRoleBinding
+// that grants cluster-gateway permission to impersonate the subject.
+func impersonateClusterRoleBindingName(subject authzv1alpah1.Subject, hubOwnerID string) string {
+	return fmt.Sprintf("impersonate-%s-%s-rolebinding", impersonateSubjectKey(subject), hubOwnerID)
+}
+
+// gatewaySubjects is the Subjects entry shared by every impersonate binding:
+// the cluster-gateway ServiceAccount that actually performs the impersonation.
+var gatewaySubjects = []rbac.Subject{
+	{
+		APIGroup:  "",
+		Kind:      "ServiceAccount",
+		Name:      "cluster-gateway",
+		Namespace: "open-cluster-management-managed-serviceaccount",
+	},
+}
+
+// applyImpersonationRBAC creates or updates the RBAC objects that let the
+// cluster-gateway ServiceAccount impersonate subject on the spoke. User and
+// Group subjects share a cluster-scoped ClusterRole/ClusterRoleBinding per
+// hub owner. ServiceAccount subjects get a namespaced Role/RoleBinding in
+// subject.Namespace instead: a ClusterRole's resourceNames carry no
+// namespace, so granting impersonation for "default" via a ClusterRole would
+// let cluster-gateway impersonate every ServiceAccount named "default" in
+// every namespace on the spoke, not just subject.Namespace's.
+func (r *ManagedClusterRoleBindingReconciler) applyImpersonationRBAC(subject authzv1alpah1.Subject, hubOwnerID string, ownerLabels map[string]string) error {
+	roleName := impersonateClusterRoleName(subject, hubOwnerID)
+	bindingName := impersonateClusterRoleBindingName(subject, hubOwnerID)
+	rule := impersonateRuleForSubject(subject)
+
+	if subject.Kind == "ServiceAccount" {
+		role := &rbac.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      roleName,
+				Namespace: subject.Namespace,
+				Labels:    ownerLabels,
+			},
+			Rules: []rbac.PolicyRule{rule},
+		}
+		if _, err := cu.CreateOrPatch(context.Background(), r.SpokeClient, role, func(obj client.Object, createOp bool) client.Object {
+			in := obj.(*rbac.Role)
+			in.Labels = role.Labels
+			in.Rules = role.Rules
+			return in
+		}); err != nil {
+			return err
+		}
+
+		roleBinding := &rbac.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bindingName,
+				Namespace: subject.Namespace,
+				Labels:    ownerLabels,
+			},
+			Subjects: gatewaySubjects,
+			RoleRef: rbac.RoleRef{
+				APIGroup: rbac.GroupName,
+				Kind:     "Role",
+				Name:     role.Name,
+			},
+		}
+		_, err := cu.CreateOrPatch(context.Background(), r.SpokeClient, roleBinding, func(obj client.Object, createOp bool) client.Object {
+			in := obj.(*rbac.RoleBinding)
+			in.Labels = roleBinding.Labels
+			in.Subjects = roleBinding.Subjects
+			in.RoleRef = roleBinding.RoleRef
+			return in
+		})
+		return err
+	}
+
+	cr := &rbac.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   roleName,
+			Labels: ownerLabels,
+		},
+		Rules: []rbac.PolicyRule{rule},
+	}
+	if _, err := cu.CreateOrPatch(context.Background(), r.SpokeClient, cr, func(obj client.Object, createOp bool) client.Object {
+		in := obj.(*rbac.ClusterRole)
+		in.Labels = cr.Labels
+		in.Rules = cr.Rules
+		return in
+	}); err != nil {
+		return err
+	}
+
+	crb := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   bindingName,
+			Labels: ownerLabels,
+		},
+		Subjects: gatewaySubjects,
+		RoleRef: rbac.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "ClusterRole",
+			Name:     cr.Name,
+		},
+	}
+	_, err := cu.CreateOrPatch(context.Background(), r.SpokeClient, crb, func(obj client.Object, createOp bool) client.Object {
+		in := obj.(*rbac.ClusterRoleBinding)
+		in.Labels = crb.Labels
+		in.Subjects = crb.Subjects
+		in.RoleRef = crb.RoleRef
+		return in
 	})
-	if err == nil {
-		for _, sa := range saList.Items {
-			if err := r.SpokeClient.Delete(context.TODO(), &sa); err != nil {
+	return err
+}
+
+// impersonateRuleForSubject builds the RBAC rule that allows impersonating the
+// given subject, covering the User, Group, and ServiceAccount kinds.
+func impersonateRuleForSubject(subject authzv1alpah1.Subject) rbac.PolicyRule {
+	resource := "users"
+	switch subject.Kind {
+	case "Group":
+		resource = "groups"
+	case "ServiceAccount":
+		resource = "serviceaccounts"
+	}
+	return rbac.PolicyRule{
+		APIGroups:     []string{""},
+		Resources:     []string{resource},
+		Verbs:         []string{"impersonate"},
+		ResourceNames: []string{subject.Name},
+	}
+}
+
+// toRBACSubject converts an API Subject into the rbac.Subject used on the
+// downstream binding, setting the APIGroup/Namespace expected for its Kind.
+func toRBACSubject(subject authzv1alpah1.Subject) rbac.Subject {
+	out := rbac.Subject{
+		Kind: subject.Kind,
+		Name: subject.Name,
+	}
+	if subject.Kind == "ServiceAccount" {
+		out.Namespace = subject.Namespace
+	} else {
+		out.APIGroup = rbac.GroupName
+	}
+	return out
+}
+
+// roleRefKey builds a stable identity for a single RoleRef/namespace pair so
+// it can be compared against the roleRefLabelKey value on live objects.
+func roleRefKey(kind, name, namespace string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s.%s", kind, name)
+	}
+	return fmt.Sprintf("%s.%s.%s", kind, name, namespace)
+}
+
+// roleRefBindingName derives a DNS1123-safe name for the downstream
+// ClusterRoleBinding/RoleBinding created for a RoleRef. ref.Name is an
+// arbitrary (Cluster)Role name (e.g. "system:aggregate-to-edit") that may
+// contain characters illegal in a metadata.name or exceed its length limit,
+// so the name is built from managedCRBName/kind plus a short hash of key
+// (the same roleRefKey already used to identify the binding) instead of
+// interpolating ref.Name raw.
+func roleRefBindingName(managedCRBName, kind, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("%s-%s-%s", managedCRBName, strings.ToLower(kind), hex.EncodeToString(sum[:])[:12])
+}
+
+// withRoleRefLabel returns a copy of labels with roleRefLabelKey set to key.
+func withRoleRefLabel(labels map[string]string, key string) map[string]string {
+	out := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		out[k] = v
+	}
+	out[roleRefLabelKey] = key
+	return out
+}
+
+// garbageCollectRemovedRoleRefs deletes downstream ClusterRoleBindings and
+// RoleBindings whose roleRefLabelKey no longer matches an entry in
+// managedCRB.RoleRefs, i.e. entries that were removed from the list since the
+// last reconcile.
+func (r *ManagedClusterRoleBindingReconciler) garbageCollectRemovedRoleRefs(managedCRB *authzv1alpah1.ManagedClusterRoleBinding, desired map[string]bool) error {
+	sel := client.MatchingLabelsSelector{
+		Selector: labels.SelectorFromSet(map[string]string{ownerUIDLabelKey: string(managedCRB.UID)}),
+	}
+
+	var crbList rbac.ClusterRoleBindingList
+	if err := r.SpokeClient.List(context.TODO(), &crbList, sel); err != nil {
+		return err
+	}
+	for i := range crbList.Items {
+		crb := &crbList.Items[i]
+		if key, ok := crb.Labels[roleRefLabelKey]; ok && !desired[key] {
+			if err := r.SpokeClient.Delete(context.TODO(), crb); err != nil {
 				return err
 			}
 		}
 	}
 
-	crList := rbac.ClusterRoleList{}
-	err = r.SpokeClient.List(context.TODO(), &crList, client.MatchingLabelsSelector{
-		Selector: labels.SelectorFromSet(managedCRB.Labels),
-	})
-	if err == nil {
-		for _, cr := range crList.Items {
-			if err := r.SpokeClient.Delete(context.TODO(), &cr); err != nil {
+	var rbList rbac.RoleBindingList
+	if err := r.SpokeClient.List(context.TODO(), &rbList, sel); err != nil {
+		return err
+	}
+	for i := range rbList.Items {
+		rb := &rbList.Items[i]
+		if key, ok := rb.Labels[roleRefLabelKey]; ok && !desired[key] {
+			if err := r.SpokeClient.Delete(context.TODO(), rb); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *ManagedClusterRoleBindingReconciler) deleteAssociatedResources(managedCRB *authzv1alpah1.ManagedClusterRoleBinding) error {
+	ownerSel := client.MatchingLabelsSelector{
+		Selector: labels.SelectorFromSet(map[string]string{ownerUIDLabelKey: string(managedCRB.UID)}),
+	}
+
+	saList := core.ServiceAccountList{}
+	if err := r.SpokeClient.List(context.TODO(), &saList, ownerSel); err == nil {
+		for i := range saList.Items {
+			if err := r.SpokeClient.Delete(context.TODO(), &saList.Items[i]); err != nil {
 				return err
 			}
 		}
 	}
 
+	// Only delete objects created for a RoleRef entry here. The shared
+	// impersonate ClusterRole(Binding)/Role(Binding) also carries
+	// ownerUIDLabelKey (so drift on it is watched too) but is intentionally
+	// shared with sibling bindings for the same subject/hub owner, so its
+	// lifecycle is governed by deleteImpersonationIfUnreferenced's refcount
+	// check below, not this unconditional sweep.
 	crbList := rbac.ClusterRoleBindingList{}
-	err = r.SpokeClient.List(context.TODO(), &crbList, client.MatchingLabelsSelector{
-		Selector: labels.SelectorFromSet(managedCRB.Labels),
-	})
-	if err == nil {
-		for _, crb := range crbList.Items {
-			if err := r.SpokeClient.Delete(context.TODO(), &crb); err != nil {
+	if err := r.SpokeClient.List(context.TODO(), &crbList, ownerSel); err == nil {
+		for i := range crbList.Items {
+			if _, ok := crbList.Items[i].Labels[roleRefLabelKey]; !ok {
+				continue
+			}
+			if err := r.SpokeClient.Delete(context.TODO(), &crbList.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	rbList := rbac.RoleBindingList{}
+	if err := r.SpokeClient.List(context.TODO(), &rbList, ownerSel); err == nil {
+		for i := range rbList.Items {
+			if _, ok := rbList.Items[i].Labels[roleRefLabelKey]; !ok {
+				continue
+			}
+			if err := r.SpokeClient.Delete(context.TODO(), &rbList.Items[i]); err != nil {
 				return err
 			}
 		}
 	}
 
+	return r.deleteImpersonationIfUnreferenced(managedCRB)
+}
+
+// deleteImpersonationIfUnreferenced removes the shared impersonate ClusterRole
+// and ClusterRoleBinding (Role and RoleBinding for a ServiceAccount subject)
+// for each of managedCRB's subjects under its hub owner, unless another ManagedClusterRoleBinding on the hub still
+// references the same subject and hub owner, in which case deleting it would
+// revoke impersonation for them too. managedCRB.Subjects may be empty if it
+// was created before MinItems=1 was enforced; ranging over it is a no-op in
+// that case rather than indexing into it.
+func (r *ManagedClusterRoleBindingReconciler) deleteImpersonationIfUnreferenced(managedCRB *authzv1alpah1.ManagedClusterRoleBinding) error {
+	_, hubOwnerID := utils.GetUserIDAndHubOwnerIDFromLabelValues(managedCRB)
+
+	var siblings authzv1alpah1.ManagedClusterRoleBindingList
+	if err := r.HubClient.List(context.TODO(), &siblings); err != nil {
+		return err
+	}
+
+	for _, subject := range managedCRB.Subjects {
+		if subjectReferencedBySibling(siblings.Items, managedCRB.UID, subject, hubOwnerID) {
+			// Another binding still needs this impersonation ClusterRole/CRB.
+			continue
+		}
+		if err := r.deleteImpersonationRBAC(subject, hubOwnerID); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// subjectReferencedBySibling reports whether some ManagedClusterRoleBinding
+// other than excludeUID lists subject among its own Subjects under the same
+// hub owner.
+func subjectReferencedBySibling(siblings []authzv1alpah1.ManagedClusterRoleBinding, excludeUID types.UID, subject authzv1alpah1.Subject, hubOwnerID string) bool {
+	for i := range siblings {
+		sibling := &siblings[i]
+		if sibling.UID == excludeUID {
+			continue
+		}
+		_, siblingHubOwnerID := utils.GetUserIDAndHubOwnerIDFromLabelValues(sibling)
+		if siblingHubOwnerID != hubOwnerID {
+			continue
+		}
+		for _, siblingSubject := range sibling.Subjects {
+			if siblingSubject == subject {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// deleteImpersonationRBAC deletes the shared impersonate ClusterRole/
+// ClusterRoleBinding (or, for a ServiceAccount subject, Role/RoleBinding) for
+// subject under hubOwnerID.
+func (r *ManagedClusterRoleBindingReconciler) deleteImpersonationRBAC(subject authzv1alpah1.Subject, hubOwnerID string) error {
+	if subject.Kind == "ServiceAccount" {
+		role := &rbac.Role{ObjectMeta: metav1.ObjectMeta{Name: impersonateClusterRoleName(subject, hubOwnerID), Namespace: subject.Namespace}}
+		if err := r.SpokeClient.Delete(context.TODO(), role); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		roleBinding := &rbac.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: impersonateClusterRoleBindingName(subject, hubOwnerID), Namespace: subject.Namespace}}
+		if err := r.SpokeClient.Delete(context.TODO(), roleBinding); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	cr := &rbac.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: impersonateClusterRoleName(subject, hubOwnerID)}}
+	if err := r.SpokeClient.Delete(context.TODO(), cr); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	crb := &rbac.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: impersonateClusterRoleBindingName(subject, hubOwnerID)}}
+	if err := r.SpokeClient.Delete(context.TODO(), crb); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// mapSpokeObjectToManagedClusterRoleBinding maps a labeled downstream object on
+// the spoke back to the ManagedClusterRoleBinding on the hub that owns it, so
+// out-of-band edits to generated bindings get reconciled.
+func (r *ManagedClusterRoleBindingReconciler) mapSpokeObjectToManagedClusterRoleBinding(ctx context.Context, obj client.Object) []reconcile.Request {
+	ownerUID, ok := obj.GetLabels()[ownerUIDLabelKey]
+	if !ok {
+		return nil
+	}
+
+	var list authzv1alpah1.ManagedClusterRoleBindingList
+	if err := r.HubClient.List(ctx, &list); err != nil {
+		return nil
+	}
+	for i := range list.Items {
+		if string(list.Items[i].UID) == ownerUID {
+			return []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(&list.Items[i])}}
+		}
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager. In addition to
+// watching ManagedClusterRoleBinding itself, it watches the downstream
+// ClusterRole, ClusterRoleBinding, Role, and RoleBinding objects on the
+// spoke's cache so drift (e.g. someone `kubectl edit`-ing a generated
+// binding) gets reverted on the next reconcile instead of only on the next
+// spec change.
 func (r *ManagedClusterRoleBindingReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&authzv1alpah1.ManagedClusterRoleBinding{}).Watches(&authzv1alpah1.ManagedClusterRoleBinding{}, &handler.EnqueueRequestForObject{}).
-		Complete(r)
+	c, err := ctrl.NewControllerManagedBy(mgr).
+		For(&authzv1alpah1.ManagedClusterRoleBinding{}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	mapFn := handler.EnqueueRequestsFromMapFunc(r.mapSpokeObjectToManagedClusterRoleBinding)
+	hasOwnerUID := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.GetLabels()[ownerUIDLabelKey]
+		return ok
+	})
+
+	for _, spokeObj := range []client.Object{&rbac.ClusterRole{}, &rbac.ClusterRoleBinding{}, &rbac.Role{}, &rbac.RoleBinding{}} {
+		if err := c.Watch(source.Kind(r.SpokeCache, spokeObj), mapFn, hasOwnerUID); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 /*
@@ -0,0 +1,236 @@
+/*
+Copyright AppsCode Inc. and Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	authzv1alpah1 "github.com/kluster-manager/cluster-auth/apis/authorization/v1alpha1"
+	"github.com/kluster-manager/cluster-auth/pkg/utils"
+
+	rbac "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := rbac.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := authzv1alpah1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+func TestGarbageCollectRemovedRoleRefs(t *testing.T) {
+	scheme := newTestScheme(t)
+	managedCRB := &authzv1alpah1.ManagedClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice-perms", UID: types.UID("uid-1")},
+	}
+
+	keep := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "alice-perms-view",
+			Labels: map[string]string{
+				ownerUIDLabelKey: "uid-1",
+				roleRefLabelKey:  "ClusterRole.view",
+			},
+		},
+	}
+	stale := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "alice-perms-edit",
+			Labels: map[string]string{
+				ownerUIDLabelKey: "uid-1",
+				roleRefLabelKey:  "ClusterRole.edit",
+			},
+		},
+	}
+	staleRB := &rbac.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "alice-perms-edit",
+			Namespace: "team-a",
+			Labels: map[string]string{
+				ownerUIDLabelKey: "uid-1",
+				roleRefLabelKey:  "Role.edit.team-a",
+			},
+		},
+	}
+	unrelated := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "bob-perms-view",
+			Labels: map[string]string{
+				ownerUIDLabelKey: "uid-2",
+				roleRefLabelKey:  "ClusterRole.edit",
+			},
+		},
+	}
+
+	spokeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(keep, stale, staleRB, unrelated).Build()
+	r := &ManagedClusterRoleBindingReconciler{SpokeClient: spokeClient}
+
+	desired := map[string]bool{"ClusterRole.view": true}
+	if err := r.garbageCollectRemovedRoleRefs(managedCRB, desired); err != nil {
+		t.Fatalf("garbageCollectRemovedRoleRefs() error = %v", err)
+	}
+
+	var crbList rbac.ClusterRoleBindingList
+	if err := spokeClient.List(context.Background(), &crbList); err != nil {
+		t.Fatal(err)
+	}
+	if len(crbList.Items) != 2 {
+		t.Fatalf("expected 2 ClusterRoleBindings to remain, got %+v", crbList.Items)
+	}
+	for _, name := range []string{"alice-perms-view", "bob-perms-view"} {
+		found := false
+		for _, item := range crbList.Items {
+			if item.Name == name {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected %q to remain, got %+v", name, crbList.Items)
+		}
+	}
+
+	var rbList rbac.RoleBindingList
+	if err := spokeClient.List(context.Background(), &rbList); err != nil {
+		t.Fatal(err)
+	}
+	if len(rbList.Items) != 0 {
+		t.Fatalf("expected stale RoleBinding to be deleted, got %+v", rbList.Items)
+	}
+}
+
+func TestDeleteImpersonationIfUnreferenced(t *testing.T) {
+	scheme := newTestScheme(t)
+
+	subject := authzv1alpah1.Subject{Kind: "User", Name: "alice"}
+	target := &authzv1alpah1.ManagedClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice-view", UID: types.UID("uid-1")},
+		Subjects:   []authzv1alpah1.Subject{subject},
+	}
+	_, hubOwnerID := utils.GetUserIDAndHubOwnerIDFromLabelValues(target)
+
+	newImpersonateObjects := func() (*rbac.ClusterRole, *rbac.ClusterRoleBinding) {
+		return &rbac.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: impersonateClusterRoleName(subject, hubOwnerID)}},
+			&rbac.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: impersonateClusterRoleBindingName(subject, hubOwnerID)}}
+	}
+
+	t.Run("deletes when unreferenced", func(t *testing.T) {
+		cr, crb := newImpersonateObjects()
+		hubClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(target.DeepCopy()).Build()
+		spokeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cr, crb).Build()
+		r := &ManagedClusterRoleBindingReconciler{HubClient: hubClient, SpokeClient: spokeClient}
+
+		if err := r.deleteImpersonationIfUnreferenced(target); err != nil {
+			t.Fatalf("deleteImpersonationIfUnreferenced() error = %v", err)
+		}
+
+		err := spokeClient.Get(context.Background(), client.ObjectKeyFromObject(cr), &rbac.ClusterRole{})
+		if !apierrors.IsNotFound(err) {
+			t.Fatalf("expected impersonate ClusterRole to be deleted, got err = %v", err)
+		}
+	})
+
+	t.Run("keeps it when a sibling with the same subject still references it", func(t *testing.T) {
+		cr, crb := newImpersonateObjects()
+		sibling := &authzv1alpah1.ManagedClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice-edit", UID: types.UID("uid-2")},
+			Subjects:   []authzv1alpah1.Subject{subject},
+		}
+		hubClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(target.DeepCopy(), sibling).Build()
+		spokeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cr, crb).Build()
+		r := &ManagedClusterRoleBindingReconciler{HubClient: hubClient, SpokeClient: spokeClient}
+
+		if err := r.deleteImpersonationIfUnreferenced(target); err != nil {
+			t.Fatalf("deleteImpersonationIfUnreferenced() error = %v", err)
+		}
+
+		if err := spokeClient.Get(context.Background(), client.ObjectKeyFromObject(cr), &rbac.ClusterRole{}); err != nil {
+			t.Fatalf("expected impersonate ClusterRole to still exist, got err = %v", err)
+		}
+	})
+
+	t.Run("deletes when a sibling targets a different subject Kind with the same Name", func(t *testing.T) {
+		cr, crb := newImpersonateObjects()
+		sibling := &authzv1alpah1.ManagedClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "alice-edit", UID: types.UID("uid-2")},
+			Subjects:   []authzv1alpah1.Subject{{Kind: "Group", Name: subject.Name}},
+		}
+		hubClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(target.DeepCopy(), sibling).Build()
+		spokeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cr, crb).Build()
+		r := &ManagedClusterRoleBindingReconciler{HubClient: hubClient, SpokeClient: spokeClient}
+
+		if err := r.deleteImpersonationIfUnreferenced(target); err != nil {
+			t.Fatalf("deleteImpersonationIfUnreferenced() error = %v", err)
+		}
+
+		err := spokeClient.Get(context.Background(), client.ObjectKeyFromObject(cr), &rbac.ClusterRole{})
+		if !apierrors.IsNotFound(err) {
+			t.Fatalf("expected impersonate ClusterRole to be deleted despite the Group sibling, got err = %v", err)
+		}
+	})
+}
+
+func TestMapSpokeObjectToManagedClusterRoleBinding(t *testing.T) {
+	scheme := newTestScheme(t)
+
+	target := &authzv1alpah1.ManagedClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice-view", UID: types.UID("uid-1")},
+	}
+	other := &authzv1alpah1.ManagedClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice-edit", UID: types.UID("uid-2")},
+	}
+	hubClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(target, other).Build()
+	r := &ManagedClusterRoleBindingReconciler{HubClient: hubClient}
+
+	labeled := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "alice-view-view",
+			Labels: map[string]string{ownerUIDLabelKey: "uid-1"},
+		},
+	}
+	requests := r.mapSpokeObjectToManagedClusterRoleBinding(context.Background(), labeled)
+	if len(requests) != 1 || requests[0].Name != "alice-view" {
+		t.Fatalf("expected a request for %q, got %+v", "alice-view", requests)
+	}
+
+	unlabeled := &rbac.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "no-label"}}
+	if requests := r.mapSpokeObjectToManagedClusterRoleBinding(context.Background(), unlabeled); requests != nil {
+		t.Fatalf("expected no requests for an object without ownerUIDLabelKey, got %+v", requests)
+	}
+
+	unknownOwner := &rbac.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "orphaned",
+			Labels: map[string]string{ownerUIDLabelKey: "uid-does-not-exist"},
+		},
+	}
+	if requests := r.mapSpokeObjectToManagedClusterRoleBinding(context.Background(), unknownOwner); requests != nil {
+		t.Fatalf("expected no requests for an unknown owner UID, got %+v", requests)
+	}
+}